@@ -0,0 +1,31 @@
+/*
+Package agent decouples "what does the player want to do" from the simulation.
+GameObject2D implementations poll an attached IPlayerAgent for an Intent each
+physical tick instead of reading the input package directly, which lets the
+same GameObject2D be driven by a human, a replay, or a script.
+*/
+package agent
+
+import (
+	"galaxyzeta.io/engine/base"
+)
+
+// NamePlayerAgent is the component name every IPlayerAgent implementation is registered under.
+const NamePlayerAgent = "PlayerAgent"
+
+// Intent is a high-level description of what a player wants to do this tick.
+type Intent struct {
+	MoveX  float64 // -1, 0 or 1: desired horizontal movement direction.
+	Jump   bool    // whether a jump was requested this tick.
+	Crouch bool    // whether crouch is being held this tick.
+	Fire   bool    // whether a fire/attack action was requested this tick.
+	Aim    float64 // desired aim angle in degrees.
+}
+
+// IPlayerAgent is an IComponent that produces an Intent for obj once per physical tick.
+type IPlayerAgent interface {
+	// GetName is an implementation of IComponent.
+	GetName() string
+	// PollIntent returns obj's desired Intent for the current physical tick.
+	PollIntent(obj base.IGameObject2D) Intent
+}
@@ -0,0 +1,34 @@
+package agent
+
+import (
+	"galaxyzeta.io/engine/base"
+	"galaxyzeta.io/engine/input"
+	"galaxyzeta.io/engine/input/keys"
+)
+
+// KeyboardAgent turns the current physical keyboard state into an Intent.
+// It is the default IPlayerAgent for a locally controlled player.
+type KeyboardAgent struct{}
+
+// NewKeyboardAgent returns a new KeyboardAgent.
+func NewKeyboardAgent() *KeyboardAgent {
+	return &KeyboardAgent{}
+}
+
+// GetName is an implementation of IComponent.
+func (a *KeyboardAgent) GetName() string {
+	return NamePlayerAgent
+}
+
+// PollIntent is an implementation of IPlayerAgent.
+func (a *KeyboardAgent) PollIntent(obj base.IGameObject2D) Intent {
+	var intent Intent
+	if input.IsKeyHeld(keys.KeyA) {
+		intent.MoveX = -1
+	} else if input.IsKeyHeld(keys.KeyD) {
+		intent.MoveX = 1
+	}
+	intent.Jump = input.IsKeyPressed(keys.KeyW)
+	intent.Crouch = input.IsKeyHeld(keys.KeyS)
+	return intent
+}
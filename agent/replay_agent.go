@@ -0,0 +1,28 @@
+package agent
+
+import (
+	"galaxyzeta.io/engine/base"
+	"galaxyzeta.io/engine/core"
+)
+
+// ReplayAgent deterministically replays a recorded Intent stream, keyed by the physical tick it
+// was captured on, as reported by core.PhysicalTick. Ticks with no recorded Intent yield a zero
+// Intent.
+type ReplayAgent struct {
+	frames map[int64]Intent
+}
+
+// NewReplayAgent returns a ReplayAgent that replays frames, a recording keyed by physical tick.
+func NewReplayAgent(frames map[int64]Intent) *ReplayAgent {
+	return &ReplayAgent{frames: frames}
+}
+
+// GetName is an implementation of IComponent.
+func (a *ReplayAgent) GetName() string {
+	return NamePlayerAgent
+}
+
+// PollIntent is an implementation of IPlayerAgent.
+func (a *ReplayAgent) PollIntent(obj base.IGameObject2D) Intent {
+	return a.frames[core.PhysicalTick()]
+}
@@ -0,0 +1,38 @@
+package agent
+
+import (
+	"galaxyzeta.io/engine/base"
+)
+
+// ScriptedAgent is a channel-fed IPlayerAgent, useful for integration tests and AI-driven
+// players: feed it Intents with Push and each poll surfaces the oldest one still queued, in the
+// order they were pushed.
+type ScriptedAgent struct {
+	intents chan Intent
+}
+
+// NewScriptedAgent returns a ScriptedAgent buffering up to bufferSize queued Intents.
+func NewScriptedAgent(bufferSize int) *ScriptedAgent {
+	return &ScriptedAgent{intents: make(chan Intent, bufferSize)}
+}
+
+// GetName is an implementation of IComponent.
+func (a *ScriptedAgent) GetName() string {
+	return NamePlayerAgent
+}
+
+// Push queues intent behind any Intents already queued; PollIntent returns them one per call.
+func (a *ScriptedAgent) Push(intent Intent) {
+	a.intents <- intent
+}
+
+// PollIntent is an implementation of IPlayerAgent. It dequeues and returns the oldest queued
+// Intent, or a zero Intent when the queue is empty.
+func (a *ScriptedAgent) PollIntent(obj base.IGameObject2D) Intent {
+	select {
+	case intent := <-a.intents:
+		return intent
+	default:
+		return Intent{}
+	}
+}
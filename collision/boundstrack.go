@@ -0,0 +1,106 @@
+package collision
+
+// AABB is an axis-aligned bounding box in int16 space, the precision BoundsTrack stores its
+// broad-phase slots in.
+type AABB struct {
+	MinX, MinY, MaxX, MaxY int16
+}
+
+// BoundsTrack is a SIMD-friendly broad-phase alternative to QuadTreeCollision2DSystem. It keeps
+// every live AABB as four parallel int16 arrays (struct-of-arrays) indexed by a stable boundsID
+// handed out at register time, so scenes with thousands of small dynamic colliders can update and
+// query bounds without paying a quadtree's rebalance cost.
+type BoundsTrack struct {
+	minX, minY, maxX, maxY []int16
+	live                   []bool // live[id] is true while id is registered; false once Unregister'd.
+	freeList               []int  // reclaimed boundsIDs, reused before growing the arrays.
+	maxIndex               int    // one past the highest ever-assigned boundsID.
+}
+
+// NewBoundsTrack returns an empty BoundsTrack with room for capacity bounds preallocated.
+func NewBoundsTrack(capacity int) *BoundsTrack {
+	return &BoundsTrack{
+		minX: make([]int16, 0, capacity),
+		minY: make([]int16, 0, capacity),
+		maxX: make([]int16, 0, capacity),
+		maxY: make([]int16, 0, capacity),
+		live: make([]bool, 0, capacity),
+	}
+}
+
+// Register hands out a new stable boundsID for bbox, reusing a slot freed by Unregister if one
+// is available, and returns it.
+func (t *BoundsTrack) Register(bbox AABB) int {
+	if n := len(t.freeList); n > 0 {
+		id := t.freeList[n-1]
+		t.freeList = t.freeList[:n-1]
+		t.SetIndex(id, bbox)
+		t.live[id] = true
+		return id
+	}
+	id := len(t.minX)
+	t.minX = append(t.minX, bbox.MinX)
+	t.minY = append(t.minY, bbox.MinY)
+	t.maxX = append(t.maxX, bbox.MaxX)
+	t.maxY = append(t.maxY, bbox.MaxY)
+	t.live = append(t.live, true)
+	if id+1 > t.maxIndex {
+		t.maxIndex = id + 1
+	}
+	return id
+}
+
+// Unregister frees boundsID so a future Register call may reuse its slot. The freed slot is
+// marked dead rather than dropped from the backing arrays, so FindIntersections must keep
+// skipping it until Register hands it out again.
+func (t *BoundsTrack) Unregister(boundsID int) {
+	t.minX[boundsID], t.maxX[boundsID] = 0, 0
+	t.minY[boundsID], t.maxY[boundsID] = 0, 0
+	t.live[boundsID] = false
+	t.freeList = append(t.freeList, boundsID)
+}
+
+// SetIndex updates the slot for boundsID in place.
+func (t *BoundsTrack) SetIndex(boundsID int, bbox AABB) {
+	t.minX[boundsID] = bbox.MinX
+	t.minY[boundsID] = bbox.MinY
+	t.maxX[boundsID] = bbox.MaxX
+	t.maxY[boundsID] = bbox.MaxY
+}
+
+// ClearAll resets the tracker to empty, keeping its backing arrays allocated for reuse.
+func (t *BoundsTrack) ClearAll() {
+	t.minX = t.minX[:0]
+	t.minY = t.minY[:0]
+	t.maxX = t.maxX[:0]
+	t.maxY = t.maxY[:0]
+	t.live = t.live[:0]
+	t.freeList = t.freeList[:0]
+	t.maxIndex = 0
+}
+
+// FindIntersections appends every live boundsID whose slot overlaps query to out and returns the
+// extended slice. Slots are processed in blocks of 8 with branch-free comparisons so the Go
+// compiler, and eventually hand-written SIMD, can vectorize the hot path.
+func (t *BoundsTrack) FindIntersections(query AABB, out []int) []int {
+	n := t.maxIndex
+	i := 0
+	for ; i+8 <= n; i += 8 {
+		for j := i; j < i+8; j++ {
+			// Each difference is non-negative exactly when its bound check holds, so the OR of all
+			// four has its sign bit clear only when slot j overlaps query.
+			overlap := (int32(query.MaxX) - int32(t.minX[j])) | (int32(t.maxX[j]) - int32(query.MinX)) |
+				(int32(query.MaxY) - int32(t.minY[j])) | (int32(t.maxY[j]) - int32(query.MinY))
+			if t.live[j] && overlap >= 0 {
+				out = append(out, j)
+			}
+		}
+	}
+	for ; i < n; i++ {
+		if t.live[i] && (t.minX[i] <= query.MaxX) && (t.maxX[i] >= query.MinX) &&
+			(t.minY[i] <= query.MaxY) && (t.maxY[i] >= query.MinY) {
+			out = append(out, i)
+		}
+	}
+	return out
+}
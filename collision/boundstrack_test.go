@@ -0,0 +1,93 @@
+package collision
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForceIntersections is the trusted reference: a plain O(n) scan over bounds, skipping
+// anything not marked live, with none of BoundsTrack's block processing or slot reuse.
+func bruteForceIntersections(bounds []AABB, live []bool, query AABB) []int {
+	var out []int
+	for i, b := range bounds {
+		if !live[i] {
+			continue
+		}
+		if b.MinX <= query.MaxX && b.MaxX >= query.MinX && b.MinY <= query.MaxY && b.MaxY >= query.MinY {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+func toSet(ids []int) map[int]bool {
+	set := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+// TestParity checks BoundsTrack.FindIntersections against a brute-force reference scan over 1800
+// random bounds (a fraction of them freed, to exercise slot reuse) plus a handful of known-overlap
+// edge cases, including a query spanning the origin over freed slots.
+func TestParity(t *testing.T) {
+	const n = 1800
+	rng := rand.New(rand.NewSource(1))
+
+	randBound := func() AABB {
+		minX := int16(rng.Intn(2000) - 1000)
+		minY := int16(rng.Intn(2000) - 1000)
+		return AABB{
+			MinX: minX,
+			MinY: minY,
+			MaxX: minX + int16(rng.Intn(50)),
+			MaxY: minY + int16(rng.Intn(50)),
+		}
+	}
+
+	bt := NewBoundsTrack(n)
+	bounds := make([]AABB, n)
+	live := make([]bool, n)
+	for i := 0; i < n; i++ {
+		b := randBound()
+		bt.Register(b)
+		bounds[i] = b
+		live[i] = true
+	}
+
+	// Free every tenth slot so FindIntersections has to keep skipping freed ids rather than just
+	// scanning a contiguous live prefix.
+	for i := 0; i < n; i += 10 {
+		bt.Unregister(i)
+		live[i] = false
+	}
+
+	queries := []AABB{
+		{MinX: -5, MinY: -5, MaxX: 5, MaxY: 5},             // spans the origin, over freed slots
+		{MinX: -1000, MinY: -1000, MaxX: 1000, MaxY: 1000}, // encloses everything
+		{MinX: 0, MinY: 0, MaxX: 0, MaxY: 0},                // degenerate point query
+	}
+	for i := 0; i < 64; i++ {
+		queries = append(queries, randBound())
+	}
+
+	for qi, q := range queries {
+		gotSet := toSet(bt.FindIntersections(q, nil))
+		wantSet := toSet(bruteForceIntersections(bounds, live, q))
+
+		if len(gotSet) != len(wantSet) {
+			t.Fatalf("query %d (%+v): got %d hits, want %d", qi, q, len(gotSet), len(wantSet))
+		}
+		for id := range wantSet {
+			if !gotSet[id] {
+				t.Fatalf("query %d (%+v): missing expected hit id %d", qi, q, id)
+			}
+		}
+		for id := range gotSet {
+			if !wantSet[id] {
+				t.Fatalf("query %d (%+v): unexpected hit id %d (live=%v)", qi, q, id, live[id])
+			}
+		}
+	}
+}
@@ -60,6 +60,28 @@ type Application struct {
 	wg       *sync.WaitGroup // wg is used for Wait() method to continue after all loops stoppped.
 	sigKill  chan struct{}
 	running  bool
+	// --- game mode
+	gameMode GameMode // gameMode governs how many sides exist and where each side's players spawn.
+}
+
+// SetGameMode installs mode as the active GameMode. Must be called before Start(); sides and
+// spawn points are only consulted once the game loop and sdk.AddPlayers start creating players.
+func (app *Application) SetGameMode(mode GameMode) {
+	if app.status == GameLoopStats_Running {
+		panic("cannot change game mode once the Application is running")
+	}
+	app.gameMode = mode
+}
+
+// GetGameMode returns the Application's active GameMode, or nil if SetGameMode was never called.
+func (app *Application) GetGameMode() GameMode {
+	return app.gameMode
+}
+
+// GetGameMode returns the running Application's active GameMode, or nil if SetGameMode was
+// never called.
+func GetGameMode() GameMode {
+	return app.gameMode
 }
 
 // NewApplication returns a new masterGameLoopController.
@@ -118,6 +140,12 @@ func (app *Application) Start() {
 	// --- infinite loop has stopped, maybe sigkill or something else
 }
 
+// PushRegister queues obj to be added to the active pool on the next doPhysicalUpdate, the same
+// path Create goes through from the SDK.
+func PushRegister(obj base.IGameObject2D, isActive bool) {
+	app.registerChannel <- resourceAccessRequest{payload: obj, isActive: &isActive}
+}
+
 // Kill terminates all sub workers.
 func (g *Application) Kill() {
 	fmt.Println("kill")
@@ -187,6 +215,8 @@ func (g *Application) doRender() {
 }
 
 func (g *Application) doPhysicalUpdate() {
+	// 0. advance the physical tick counter, used by Snapshot/Restore to identify a world state.
+	physicalTick++
 	// 1. check whether there are items to create
 	for len(g.registerChannel) > 0 {
 		req := <-g.registerChannel
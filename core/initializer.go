@@ -29,6 +29,11 @@ func GlobalInitializer() {
 	inputBuffer[KeyPress] = map[keys.Key]struct{}{}
 	inputBuffer[KeyHold] = map[keys.Key]struct{}{}
 	inputBuffer[KeyRelease] = map[keys.Key]struct{}{}
+
+	// init snapshot/restore bookkeeping
+	physicalTick = 0
+	rngSeed = 0
+	factoryRegistry = make(map[string]InstantiateFunc)
 }
 
 // objPoolInit inits a map[label]objPool. Reduce duplicated code.
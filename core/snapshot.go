@@ -0,0 +1,174 @@
+package core
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"galaxyzeta.io/engine/base"
+	"galaxyzeta.io/engine/ecs/component"
+)
+
+// physicalTick counts doPhysicalUpdate calls since the Application started, or since the last
+// Restore; doPhysicalUpdate increments it first, so during an update it includes that update. It is part of the serialized world state so a restored snapshot resumes
+// replays and netcode at the same tick it was taken on.
+var physicalTick int64
+
+// rngSeed is the seed driving any deterministic randomness in the simulation. Snapshot/Restore
+// persist it alongside physicalTick so a restored world reproduces identical future rolls.
+var rngSeed int64
+
+// factoryRegistry maps a GameObject2D's Name to the InstantiateFunc that constructs it, so
+// Restore can rebuild every object from scratch rather than guessing at its constructor.
+var factoryRegistry map[string]InstantiateFunc
+
+// PhysicalTick returns the number of doPhysicalUpdate calls since the Application started, or
+// since the last Restore, counting the update in progress when called from within one. Agents such as ReplayAgent use this to key recorded frames
+// to the engine's actual tick rather than keeping their own counter out of sync with it.
+func PhysicalTick() int64 {
+	return physicalTick
+}
+
+// RegisterFactory associates name (as returned by GameObject2D.Name) with the constructor used to
+// recreate it during Restore. Call this once per object type during game init, before Snapshot or
+// Restore is ever used.
+func RegisterFactory(name string, ctor InstantiateFunc) {
+	factoryRegistry[name] = ctor
+}
+
+// SeedRNG sets the seed persisted by Snapshot and restored by Restore.
+func SeedRNG(seed int64) {
+	rngSeed = seed
+}
+
+// Instantiate constructs a new object via the factory registered under name with RegisterFactory.
+// It panics if no such factory is registered.
+func Instantiate(name string) base.IGameObject2D {
+	ctor, ok := factoryRegistry[name]
+	if !ok {
+		panic(fmt.Sprintf("core: Instantiate: no factory registered for %q, call RegisterFactory first", name))
+	}
+	return ctor()
+}
+
+// snapshotObject is the gob-serializable record for a single IGameObject2D.
+type snapshotObject struct {
+	Name        string
+	Label       label
+	IsActive    bool
+	Transform   *component.Transform2D
+	RigidBody   *component.RigidBody2D
+	Collider    *component.PolygonCollider
+	SpriteFrame int
+}
+
+// worldSnapshot is the gob-serializable record for the whole simulation.
+type worldSnapshot struct {
+	Tick    int64
+	Seed    int64
+	Objects []snapshotObject
+}
+
+// Snapshot serializes every active GameObject2D's registered Transform2D, RigidBody2D and
+// PolygonCollider components, plus its sprite's animation frame, the current physical tick and
+// the RNG seed, into a gob-encoded buffer suitable for save/load, deterministic replay or
+// one-frame rollback.
+func Snapshot() ([]byte, error) {
+	snap := worldSnapshot{Tick: physicalTick, Seed: rngSeed}
+
+	mutexList[Mutex_ActivePool].RLock()
+	for lbl, pool := range activePool {
+		for iobj := range pool {
+			go2d := iobj.GetGameObject2D()
+
+			var rb *component.RigidBody2D
+			if v, ok := go2d.GetComponent(component.NameRigidBody2D).(*component.RigidBody2D); ok {
+				rb = v
+			}
+			var pc *component.PolygonCollider
+			if v, ok := go2d.GetComponent(component.NamePolygonCollider).(*component.PolygonCollider); ok {
+				pc = v
+			}
+			frame := 0
+			if go2d.Sprite != nil {
+				frame = go2d.Sprite.GetFrameIndex()
+			}
+
+			snap.Objects = append(snap.Objects, snapshotObject{
+				Name:        go2d.Name,
+				Label:       lbl,
+				IsActive:    go2d.IsActive,
+				Transform:   go2d.GetComponent(component.NameTransform2D).(*component.Transform2D),
+				RigidBody:   rb,
+				Collider:    pc,
+				SpriteFrame: frame,
+			})
+		}
+	}
+	mutexList[Mutex_ActivePool].RUnlock()
+
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(snap); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Restore replaces the current world with the one serialized in buf by a prior call to Snapshot.
+// It first drains registerChannel and unregisterChannel, since any request queued against the
+// pre-restore world no longer applies, then rebuilds activePool from factoryRegistry before
+// copying each object's recorded component state back in. inactivePool is left unchanged.
+func Restore(buf []byte) error {
+	for len(app.registerChannel) > 0 {
+		<-app.registerChannel
+	}
+	for len(app.unregisterChannel) > 0 {
+		<-app.unregisterChannel
+	}
+
+	var snap worldSnapshot
+	if err := gob.NewDecoder(bytes.NewReader(buf)).Decode(&snap); err != nil {
+		return err
+	}
+
+	mutexList[Mutex_ActivePool].Lock()
+	defer mutexList[Mutex_ActivePool].Unlock()
+
+	objPoolInit(&activePool)
+	for _, rec := range snap.Objects {
+		ctor, ok := factoryRegistry[rec.Name]
+		if !ok {
+			return fmt.Errorf("core: Restore: no factory registered for object %q, call RegisterFactory first", rec.Name)
+		}
+		iobj := ctor()
+		go2d := iobj.GetGameObject2D()
+		go2d.IsActive = rec.IsActive
+
+		*go2d.GetComponent(component.NameTransform2D).(*component.Transform2D) = *rec.Transform
+		if rec.RigidBody != nil {
+			if rb, ok := go2d.GetComponent(component.NameRigidBody2D).(*component.RigidBody2D); ok {
+				*rb = *rec.RigidBody
+			}
+		}
+		if rec.Collider != nil {
+			if pc, ok := go2d.GetComponent(component.NamePolygonCollider).(*component.PolygonCollider); ok {
+				// Only the shape is restored, not the whole struct: pc already carries the owner
+				// set by this object's own factory, while rec.Collider's owner is whatever
+				// GobDecode left it at (see polygoncollider_gob.go) and must not replace it.
+				pc.Collider = rec.Collider.Collider
+			}
+		}
+		if go2d.Sprite != nil {
+			go2d.Sprite.SetFrameIndex(rec.SpriteFrame)
+		}
+
+		if _, ok := activePool[rec.Label]; !ok {
+			activePool[rec.Label] = make(objPool)
+		}
+		activePool[rec.Label][iobj] = struct{}{}
+	}
+
+	physicalTick = snap.Tick
+	rngSeed = snap.Seed
+	return nil
+}
@@ -0,0 +1,102 @@
+package component
+
+import (
+	"galaxyzeta.io/engine/base"
+	"galaxyzeta.io/engine/collision"
+	"galaxyzeta.io/engine/linalg"
+)
+
+const NameCharacterController2D = "CharacterController2D"
+
+// CharacterController2D is a reusable capsule-like character controller: a circular radius
+// combined with a standing and a crouching height. It bundles the RigidBody2D and
+// PolygonCollider driving it so object step callbacks no longer need to reimplement ground
+// checks before jumping, or crouch/stand transitions, themselves. It applies no cooldown between
+// jumps.
+type CharacterController2D struct {
+	*RigidBody2D
+	*PolygonCollider
+
+	Radius          float64
+	StandingHeight  float64
+	CrouchingHeight float64
+	IsGrounded      bool
+	IsCrouching     bool
+
+	// owner builds the throwaway PolygonCollider TryStandup overlap-tests the upper capsule
+	// segment with; it plays no other role.
+	owner base.IGameObject2D
+}
+
+// NewCharacterController2D returns a new CharacterController2D of the given capsule dimensions,
+// driving rb and shaped by pc. owner is the GameObject2D pc and rb belong to.
+func NewCharacterController2D(radius, standingHeight, crouchingHeight float64, rb *RigidBody2D, pc *PolygonCollider, owner base.IGameObject2D) *CharacterController2D {
+	return &CharacterController2D{
+		RigidBody2D:     rb,
+		PolygonCollider: pc,
+		Radius:          radius,
+		StandingHeight:  standingHeight,
+		CrouchingHeight: crouchingHeight,
+		owner:           owner,
+	}
+}
+
+// GetName is an implementation of IComponent.
+func (c *CharacterController2D) GetName() string {
+	return NameCharacterController2D
+}
+
+// TryJump adds an upward impulse of force to the controller's rigid body, if it is grounded.
+// Returns whether the jump was applied.
+func (c *CharacterController2D) TryJump(force float64) bool {
+	if !c.IsGrounded {
+		return false
+	}
+	c.AddForce(SpeedVector{
+		Direction:    90,
+		Speed:        force,
+		Acceleration: force / 60,
+	})
+	c.IsGrounded = false
+	return true
+}
+
+// TryCrouch marks the controller as crouching by setting IsCrouching. It always succeeds. The
+// PolygonCollider is not resized.
+func (c *CharacterController2D) TryCrouch() bool {
+	c.IsCrouching = true
+	return true
+}
+
+// TryStandup attempts to switch the controller back to standing by clearing IsCrouching. It
+// overlap-tests the upper capsule segment the stand transition would add - its own rectangle of
+// width 2*Radius and height 2*halfHeight, where halfHeight = (StandingHeight-CrouchingHeight-2*Radius)/2,
+// resting on top of the current collider - against csys, and keeps IsCrouching set if that
+// segment is blocked by a "solid" collider, matching the standard PhysX-style controller
+// behavior. The PolygonCollider is not resized. Returns whether the controller is now standing.
+func (c *CharacterController2D) TryStandup(csys collision.ICollisionSystem) bool {
+	if !c.IsCrouching {
+		return true
+	}
+	halfHeight := (c.StandingHeight - c.CrouchingHeight - 2*c.Radius) / 2
+	if halfHeight < 0 {
+		halfHeight = 0
+	}
+
+	rect := c.Collider.GetBoundingBox().ToRectangle()
+	cx := rect.X + rect.Width/2
+	segBottom := rect.Y // rests on top of the crouching collider's current bounding box
+	segTop := segBottom - 2*halfHeight
+	vertices := []linalg.Vector2f64{
+		{X: cx - c.Radius, Y: segTop},
+		{X: cx + c.Radius, Y: segTop},
+		{X: cx + c.Radius, Y: segBottom},
+		{X: cx - c.Radius, Y: segBottom},
+	}
+	upperSegment := NewPolygonCollider(vertices, c.owner).Collider
+	if collision.HasColliderAtPolygonWithTag(csys, upperSegment, "solid") {
+		return false
+	}
+	c.IsCrouching = false
+	return true
+}
@@ -0,0 +1,44 @@
+package component
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"galaxyzeta.io/engine/linalg"
+)
+
+var (
+	_ gob.GobEncoder = (*PolygonCollider)(nil)
+	_ gob.GobDecoder = (*PolygonCollider)(nil)
+)
+
+// polygonColliderSnapshot is the gob-serializable mirror of PolygonCollider's state. Collider's
+// vertex data lives entirely behind its own query methods (Shift, GetBoundingBox, GetVertices),
+// so the default reflection-based gob encoding would see nothing to write for it; Collider is
+// rebuilt from its vertices instead, the same way NewPolygonCollider builds it in the first place.
+type polygonColliderSnapshot struct {
+	Vertices []linalg.Vector2f64
+}
+
+// GobEncode is an implementation of gob.GobEncoder.
+func (pc *PolygonCollider) GobEncode() ([]byte, error) {
+	snap := polygonColliderSnapshot{Vertices: pc.Collider.GetVertices()}
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(snap); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode is an implementation of gob.GobDecoder. It only touches the Collider field: owner is
+// left at its zero value here because core.Restore copies just the decoded Collider onto the
+// PolygonCollider a freshly rebuilt GameObject2D already carries, rather than replacing the whole
+// struct, so the real owner set by that object's factory is never disturbed.
+func (pc *PolygonCollider) GobDecode(data []byte) error {
+	var snap polygonColliderSnapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&snap); err != nil {
+		return err
+	}
+	pc.Collider = NewPolygonCollider(snap.Vertices, nil).Collider
+	return nil
+}
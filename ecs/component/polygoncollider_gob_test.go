@@ -0,0 +1,37 @@
+package component
+
+import (
+	"testing"
+
+	"galaxyzeta.io/engine/linalg"
+)
+
+func TestPolygonColliderGobRoundTrip(t *testing.T) {
+	vertices := []linalg.Vector2f64{
+		{X: 0, Y: 0},
+		{X: 10, Y: 0},
+		{X: 10, Y: 10},
+		{X: 0, Y: 10},
+	}
+	pc := NewPolygonCollider(vertices, nil)
+
+	data, err := pc.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode: %v", err)
+	}
+
+	var decoded PolygonCollider
+	if err := decoded.GobDecode(data); err != nil {
+		t.Fatalf("GobDecode: %v", err)
+	}
+
+	got := decoded.Collider.GetVertices()
+	if len(got) != len(vertices) {
+		t.Fatalf("got %d vertices, want %d", len(got), len(vertices))
+	}
+	for i, want := range vertices {
+		if got[i] != want {
+			t.Errorf("vertex %d = %v, want %v", i, got[i], want)
+		}
+	}
+}
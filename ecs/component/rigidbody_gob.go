@@ -0,0 +1,59 @@
+package component
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+var (
+	_ gob.GobEncoder = (*RigidBody2D)(nil)
+	_ gob.GobDecoder = (*RigidBody2D)(nil)
+)
+
+// rigidBody2DSnapshot is the gob-serializable mirror of RigidBody2D's state. The live speed list
+// is a container/list.List of SpeedVector with all-unexported internals, which the default
+// reflection-based gob encoding cannot see, so it is flattened to a slice here instead.
+type rigidBody2DSnapshot struct {
+	UseGravity    bool
+	GravityVector SpeedVector
+	SpeedVectors  []SpeedVector
+}
+
+// GobEncode is an implementation of gob.GobEncoder. It flattens the speed linked list into
+// snap.SpeedVectors alongside UseGravity and GravityVector.
+func (rb *RigidBody2D) GobEncode() ([]byte, error) {
+	snap := rigidBody2DSnapshot{
+		UseGravity:    rb.UseGravity,
+		GravityVector: rb.GravityVector,
+	}
+	list := rb.GetSpeedList()
+	for e := list.Front(); e != nil; e = e.Next() {
+		snap.SpeedVectors = append(snap.SpeedVectors, e.Value.(SpeedVector))
+	}
+
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(snap); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode is an implementation of gob.GobDecoder. It restores UseGravity and GravityVector,
+// then rebuilds the speed linked list from the flattened slice.
+func (rb *RigidBody2D) GobDecode(data []byte) error {
+	var snap rigidBody2DSnapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&snap); err != nil {
+		return err
+	}
+	rb.UseGravity = snap.UseGravity
+	rb.GravityVector = snap.GravityVector
+
+	list := rb.GetSpeedList()
+	for list.Len() > 0 {
+		list.Remove(list.Front())
+	}
+	for _, sv := range snap.SpeedVectors {
+		list.PushBack(sv)
+	}
+	return nil
+}
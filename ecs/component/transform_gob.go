@@ -0,0 +1,51 @@
+package component
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"math"
+
+	"galaxyzeta.io/engine/infra/logger"
+)
+
+var transformGobLogger = logger.New("Transform2D")
+
+// transformGobDebug gates the decode-time logging in GobDecode.
+const transformGobDebug = false
+
+var (
+	_ gob.GobEncoder = (*Transform2D)(nil)
+	_ gob.GobDecoder = (*Transform2D)(nil)
+)
+
+// GobEncode is an implementation of gob.GobEncoder. prevPos is unexported, so the default
+// reflection-based gob encoding would see nothing to write for it, so Transform2D streams Pos
+// and prevPos as four raw float64 bits instead.
+func (tf *Transform2D) GobEncode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	for _, v := range [4]float64{tf.prevPos.X, tf.prevPos.Y, tf.Pos.X, tf.Pos.Y} {
+		if err := binary.Write(buf, binary.LittleEndian, math.Float64bits(v)); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode is an implementation of gob.GobDecoder. It reads prevPos and Pos back sequentially,
+// short-circuiting on the first decode error.
+func (tf *Transform2D) GobDecode(data []byte) error {
+	r := bytes.NewReader(data)
+	targets := [4]*float64{&tf.prevPos.X, &tf.prevPos.Y, &tf.Pos.X, &tf.Pos.Y}
+	for _, target := range targets {
+		var bits uint64
+		if err := binary.Read(r, binary.LittleEndian, &bits); err != nil {
+			return err
+		}
+		*target = math.Float64frombits(bits)
+	}
+	if transformGobDebug {
+		transformGobLogger.Debugf("decoded Transform2D prevPos=%v pos=%v", tf.prevPos, tf.Pos)
+	}
+	return nil
+}
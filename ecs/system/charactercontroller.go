@@ -0,0 +1,77 @@
+package system
+
+import (
+	"galaxyzeta.io/engine/base"
+	"galaxyzeta.io/engine/collision"
+	"galaxyzeta.io/engine/ecs/component"
+	cc "galaxyzeta.io/engine/infra/concurrency"
+)
+
+// NameCharacterControllerSystem is the registered name of CharacterControllerSystem.
+var NameCharacterControllerSystem = "sys_CharacterController2D"
+
+// CharacterControllerSystem owns ground detection and surface snapping for every registered
+// CharacterController2D, and switches gravity off while grounded, so it must run before
+// Physics2DSystem. It adds no SpeedVectors itself: a jump's SpeedVector is added by
+// CharacterController2D.TryJump when that is called.
+type CharacterControllerSystem struct {
+	*base.SystemBase
+	csys     collision.ICollisionSystem
+	obj2data map[base.IGameObject2D]*component.CharacterController2D
+}
+
+// NewCharacterControllerSystem returns a new CharacterControllerSystem.
+func NewCharacterControllerSystem(priority int, csys collision.ICollisionSystem) *CharacterControllerSystem {
+	return &CharacterControllerSystem{
+		SystemBase: base.NewSystemBase(priority),
+		csys:       csys,
+		obj2data:   make(map[base.IGameObject2D]*component.CharacterController2D, 64),
+	}
+}
+
+func (s *CharacterControllerSystem) execute(iobj base.IGameObject2D, ctrl *component.CharacterController2D) {
+	tf := iobj.GetGameObject2D().GetComponent(component.NameTransform2D).(*component.Transform2D)
+
+	testPoly := ctrl.Collider.Shift(0, 1)
+	if val := collision.ColliderAtPolygonWithTag(s.csys, testPoly, "solid"); val != nil {
+		ctrl.IsGrounded = true
+		ctrl.UseGravity = false
+		ctrl.GravityVector.Speed = 0
+
+		// stick to the surface, do some trajectory correction
+		thisY := ctrl.Collider.GetBoundingBox().GetBottomLeftPoint().Y
+		colliderY := val.Collider.GetBoundingBox().GetTopLeftPoint().Y
+		tf.Pos.Y += (colliderY - thisY)
+	} else {
+		ctrl.IsGrounded = false
+		ctrl.UseGravity = true
+	}
+}
+
+// ===== IMPLEMENTATION =====
+
+func (s *CharacterControllerSystem) Execute(executor *cc.Executor) {
+	for iobj, ctrl := range s.obj2data {
+		executor.AsyncExecute(func() (interface{}, error) {
+			s.execute(iobj, ctrl)
+			return nil, nil
+		})
+	}
+}
+
+func (s *CharacterControllerSystem) GetSystemBase() *base.SystemBase {
+	return s.SystemBase
+}
+
+func (s *CharacterControllerSystem) GetName() string {
+	return NameCharacterControllerSystem
+}
+
+func (s *CharacterControllerSystem) Register(iobj base.IGameObject2D) {
+	ctrl := iobj.GetGameObject2D().GetComponent(component.NameCharacterController2D).(*component.CharacterController2D)
+	s.obj2data[iobj] = ctrl
+}
+
+func (s *CharacterControllerSystem) Unregister(iobj base.IGameObject2D) {
+	delete(s.obj2data, iobj)
+}
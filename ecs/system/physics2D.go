@@ -1,6 +1,7 @@
 package system
 
 import (
+	"container/list"
 	"math"
 
 	"galaxyzeta.io/engine/base"
@@ -15,6 +16,15 @@ import (
 
 var NamePhysics2DSystem = "sys_Physics2D"
 
+// IntegrationMethod selects how Physics2DSystem advances velocity and position each physical tick.
+type IntegrationMethod int8
+
+const (
+	IntegrationMethod_Euler = iota
+	IntegrationMethod_Midpoint
+	IntegrationMethod_RK4
+)
+
 // PhysicalComponentWrapper wraps RigidBody2D and Transform component.
 type PhysicalComponentWrapper struct {
 	*component.RigidBody2D
@@ -24,55 +34,187 @@ type PhysicalComponentWrapper struct {
 
 type Physics2DSystem struct {
 	*base.SystemBase
-	csys     collision.ICollisionSystem
-	obj2data map[base.IGameObject2D]PhysicalComponentWrapper
-	logger   *logger.Logger
+	csys        collision.ICollisionSystem
+	obj2data    map[base.IGameObject2D]PhysicalComponentWrapper
+	logger      *logger.Logger
+	integration IntegrationMethod
+
+	// broadPhase, when set via SetBroadPhase, is kept in sync with obj2data by Register/Unregister
+	// and execute, which refreshes each object's slot every tick and uses FindIntersections to
+	// skip the fine collision.HasColliderAtPolygonWithTag check on scenes with thousands of small
+	// dynamic colliders where most of them have no neighbor nearby. BoundsTrack only understands
+	// raw AABBs, not tags or PolygonColliders, so it is not itself a collision.ICollisionSystem and
+	// cannot be passed as csys - this is strictly an opt-in narrowing pass in front of csys.
+	broadPhase *collision.BoundsTrack
+	toAABB     func(*component.PolygonCollider) collision.AABB
+	boundsIDs  map[base.IGameObject2D]int
 }
 
 func NewPhysics2DSystem(prioriy int, csys collision.ICollisionSystem) *Physics2DSystem {
 	return &Physics2DSystem{
-		obj2data:   make(map[base.IGameObject2D]PhysicalComponentWrapper, 64),
-		SystemBase: base.NewSystemBase(prioriy),
-		csys:       csys,
-		logger:     logger.New("Physics2D"),
+		obj2data:    make(map[base.IGameObject2D]PhysicalComponentWrapper, 64),
+		SystemBase:  base.NewSystemBase(prioriy),
+		csys:        csys,
+		logger:      logger.New("Physics2D"),
+		integration: IntegrationMethod_Euler,
+	}
+}
+
+// SetIntegrationMethod changes how every registered rigid body's dv/dt = a(t, v) is integrated
+// on the next tick. Defaults to IntegrationMethod_Euler.
+func (s *Physics2DSystem) SetIntegrationMethod(method IntegrationMethod) {
+	s.integration = method
+}
+
+// SetBroadPhase opts Physics2DSystem into tracking every registered PolygonCollider's bounds in
+// bt, converted via toAABB. Call before Start(); Register/Unregister keep bt in sync from then on.
+// Use BroadPhaseCandidates to query it.
+func (s *Physics2DSystem) SetBroadPhase(bt *collision.BoundsTrack, toAABB func(*component.PolygonCollider) collision.AABB) {
+	s.broadPhase = bt
+	s.toAABB = toAABB
+	s.boundsIDs = make(map[base.IGameObject2D]int, len(s.obj2data))
+}
+
+// BroadPhaseCandidates returns the boundsIDs of every tracked collider whose bounds overlap
+// query, or nil if SetBroadPhase was never called. Results are in terms of the ids handed out by
+// the BoundsTrack passed to SetBroadPhase.
+func (s *Physics2DSystem) BroadPhaseCandidates(query collision.AABB) []int {
+	if s.broadPhase == nil {
+		return nil
 	}
+	return s.broadPhase.FindIntersections(query, nil)
 }
 
-func (s *Physics2DSystem) execute(item PhysicalComponentWrapper) {
+// vectorStep is the fixed-direction scalar state of one force contributor (a SpeedVector or
+// gravity): its current speed along (cos, sin), and the constant signed acceleration driving
+// dv/dt. Reducing to one scalar dimension keeps the Euler/Midpoint/RK4 math in integrate simple.
+type vectorStep struct {
+	speed    float64
+	accel    float64
+	cos, sin float64
+}
+
+// integrate advances a vectorStep by h ticks and returns the resulting speed plus the (dx, dy)
+// displacement contributed over the step, using the system's configured IntegrationMethod.
+func (s *Physics2DSystem) integrate(vs vectorStep, h float64) (newSpeed, dx, dy float64) {
+	switch s.integration {
+	case IntegrationMethod_Midpoint:
+		// sample acceleration at t+h/2 using an Euler half-step of v, then apply the full step
+		// with those midpoint values.
+		midSpeed := vs.speed + h/2*vs.accel
+		newSpeed = vs.speed + h*vs.accel
+		dx = h * midSpeed * vs.cos
+		dy = h * midSpeed * vs.sin
+	case IntegrationMethod_RK4:
+		k1v, k1x := vs.accel, vs.speed
+		k2v, k2x := vs.accel, vs.speed+h/2*k1v
+		k3v, k3x := vs.accel, vs.speed+h/2*k2v
+		k4v, k4x := vs.accel, vs.speed+h*k3v
+
+		newSpeed = vs.speed + h/6*(k1v+2*k2v+2*k3v+k4v)
+		avgSpeed := (k1x + 2*k2x + 2*k3x + k4x) / 6
+		dx = h * avgSpeed * vs.cos
+		dy = h * avgSpeed * vs.sin
+	default: // IntegrationMethod_Euler
+		newSpeed = vs.speed + h*vs.accel
+		dx = h * vs.speed * vs.cos
+		dy = h * vs.speed * vs.sin
+	}
+	return
+}
+
+// sweptAABB returns base translated by (dx, dy) - the broad-phase bound for the position
+// item.Collider.Shift(dx, dy) would occupy, used to narrow the candidate set before paying for a
+// fine collision.HasColliderAtPolygonWithTag check.
+func sweptAABB(base collision.AABB, dx, dy float64) collision.AABB {
+	ix, iy := int16(math.Round(dx)), int16(math.Round(dy))
+	return collision.AABB{
+		MinX: base.MinX + ix, MaxX: base.MaxX + ix,
+		MinY: base.MinY + iy, MaxY: base.MaxY + iy,
+	}
+}
+
+// hasBroadPhaseNeighbor reports whether query overlaps any tracked boundsID other than selfID.
+func (s *Physics2DSystem) hasBroadPhaseNeighbor(selfID int, query collision.AABB) bool {
+	for _, id := range s.BroadPhaseCandidates(query) {
+		if id != selfID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Physics2DSystem) execute(iobj base.IGameObject2D, item PhysicalComponentWrapper) {
+	const h = 1.0
 	linkedList := item.RigidBody2D.GetSpeedList()
 	var dx, dy float64
+
+	// If SetBroadPhase is active and this object is tracked, refresh its slot with the current
+	// bounds (it may have moved since last tick) and use it to skip the fine collision.
+	// HasColliderAtPolygonWithTag checks below whenever no other tracked bounds is even nearby.
+	haveBroadPhase := false
+	var selfID int
+	var selfAABB collision.AABB
+	if s.broadPhase != nil && item.PolygonCollider != nil {
+		if id, ok := s.boundsIDs[iobj]; ok {
+			selfID = id
+			selfAABB = s.toAABB(item.PolygonCollider)
+			s.broadPhase.SetIndex(selfID, selfAABB)
+			haveBroadPhase = true
+		}
+	}
+	definitelyClear := func(dx, dy float64) bool {
+		return haveBroadPhase && !s.hasBroadPhaseNeighbor(selfID, sweptAABB(selfAABB, dx, dy))
+	}
+
+	type integrated struct {
+		element *list.Element
+		val     component.SpeedVector
+	}
+	results := make([]integrated, 0, linkedList.Len())
+
 	for element := linkedList.Front(); element != nil; element = element.Next() {
 		val := element.Value.(component.SpeedVector)
 		deg := linalg.Deg2Rad(linalg.InvertDeg(val.Direction))
-		dx += val.Speed * math.Cos(deg)
-		dy += val.Speed * math.Sin(deg)
+		_, stepDx, stepDy := s.integrate(vectorStep{speed: val.Speed, accel: -val.Acceleration, cos: math.Cos(deg), sin: math.Sin(deg)}, h)
+		dx += stepDx
+		dy += stepDy
 		core.RenderCmdChan <- func() {
 			graphics.DrawSegment(linalg.NewSegmentf64(item.X(), item.Y(), item.X()+dx*10, item.Y()+dy*10), linalg.NewRgbaF64(0, 1, 0, 1))
 		}
-		// do speed atten
+		results = append(results, integrated{element: element, val: val})
+	}
+
+	// reject collision movement runs once per full step against the final dx, dy below; speed
+	// attenuation happens after the integrated step so short-lived impulses still decay predictably.
+	for _, r := range results {
+		val := r.val
 		if val.Speed > 0 {
 			val.Speed -= val.Acceleration
 			if val.Speed < 0 {
-				s.logger.Debugf("remove force vector = %v", element)
-				linkedList.Remove(element)
+				s.logger.Debugf("remove force vector = %v", r.element)
+				linkedList.Remove(r.element)
 				continue
 			}
 		}
-		element.Value = val
-
+		r.element.Value = val
 	}
+
 	// constant gravity effect
 	if item.UseGravity {
 		// judge should apply gravity
 		gdeg := linalg.Deg2Rad(linalg.InvertDeg(item.GravityVector.Direction))
-		gdx := item.GravityVector.Speed * math.Cos(gdeg)
-		gdy := item.GravityVector.Speed * math.Sin(gdeg)
-		if collision.HasColliderAtPolygonWithTag(s.csys, item.Collider.Shift(dx+gdx, dy+gdy), "solid") {
+		gNewSpeed, gdx, gdy := s.integrate(vectorStep{speed: item.GravityVector.Speed, accel: item.GravityVector.Acceleration, cos: math.Cos(gdeg), sin: math.Sin(gdeg)}, h)
+		grounded := false
+		if !definitelyClear(dx+gdx, dy+gdy) {
+			grounded = collision.HasColliderAtPolygonWithTag(s.csys, item.Collider.Shift(dx+gdx, dy+gdy), "solid")
+		}
+		if grounded {
 			// grounded
 			item.GravityVector.Speed = 0
 		} else {
 			// use gravity
-			item.GravityVector.Speed += item.GravityVector.Acceleration
+			item.GravityVector.Speed = gNewSpeed
 			dx += gdx
 			dy += gdy
 		}
@@ -87,10 +229,12 @@ func (s *Physics2DSystem) execute(item PhysicalComponentWrapper) {
 		return
 	}
 	// reject collision caused movement
-	if !collision.HasColliderAtPolygonWithTag(s.csys, item.Collider.Shift(dx, 0), "solid") {
+	blockedX := !definitelyClear(dx, 0) && collision.HasColliderAtPolygonWithTag(s.csys, item.Collider.Shift(dx, 0), "solid")
+	if !blockedX {
 		item.Transform2D.Pos.X += dx
 	}
-	if !collision.HasColliderAtPolygonWithTag(s.csys, item.Collider.Shift(0, dy), "solid") {
+	blockedY := !definitelyClear(0, dy) && collision.HasColliderAtPolygonWithTag(s.csys, item.Collider.Shift(0, dy), "solid")
+	if !blockedY {
 		item.Transform2D.Pos.Y += dy
 	}
 }
@@ -98,9 +242,9 @@ func (s *Physics2DSystem) execute(item PhysicalComponentWrapper) {
 // ===== IMPLEMENTATION =====
 
 func (s *Physics2DSystem) Execute(executor *cc.Executor) {
-	for _, item := range s.obj2data {
+	for iobj, item := range s.obj2data {
 		executor.AsyncExecute(func() (interface{}, error) {
-			s.execute(item)
+			s.execute(iobj, item)
 			return nil, nil
 		})
 	}
@@ -123,8 +267,19 @@ func (s *Physics2DSystem) Register(iobj base.IGameObject2D) {
 		Transform2D:     tf,
 		PolygonCollider: pc,
 	}
+
+	if s.broadPhase != nil && pc != nil {
+		s.boundsIDs[iobj] = s.broadPhase.Register(s.toAABB(pc))
+	}
 }
 
 func (s *Physics2DSystem) Unregister(iobj base.IGameObject2D) {
 	delete(s.obj2data, iobj)
+
+	if s.broadPhase != nil {
+		if id, ok := s.boundsIDs[iobj]; ok {
+			s.broadPhase.Unregister(id)
+			delete(s.boundsIDs, iobj)
+		}
+	}
 }
@@ -0,0 +1,67 @@
+package system
+
+import (
+	"math"
+	"testing"
+)
+
+// analyticParabola returns the displacement at time t of a particle with initial speed v0 under
+// constant acceleration a: x(t) = v0*t + 0.5*a*t^2.
+func analyticParabola(v0, a, t float64) float64 {
+	return v0*t + 0.5*a*t*t
+}
+
+// TestIntegrationMethodsAgainstAnalyticParabola drives integrate with a constant-acceleration
+// vectorStep (a one-dimensional stand-in for a projectile under gravity) and checks the resulting
+// trajectory against the analytic parabola. Midpoint and RK4 reduce to the exact closed form for
+// constant acceleration; Euler is first-order and accumulates a known, bounded error instead.
+func TestIntegrationMethodsAgainstAnalyticParabola(t *testing.T) {
+	const (
+		v0    = 5.0
+		accel = -0.2
+		h     = 1.0
+		ticks = 20
+	)
+
+	cases := []struct {
+		name      string
+		method    IntegrationMethod
+		wantExact bool
+	}{
+		{"Euler", IntegrationMethod_Euler, false},
+		{"Midpoint", IntegrationMethod_Midpoint, true},
+		{"RK4", IntegrationMethod_RK4, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewPhysics2DSystem(0, nil)
+			s.SetIntegrationMethod(c.method)
+
+			speed := v0
+			var x float64
+			for tick := 1; tick <= ticks; tick++ {
+				var dx float64
+				speed, dx, _ = s.integrate(vectorStep{speed: speed, accel: accel, cos: 1, sin: 0}, h)
+				x += dx
+
+				want := analyticParabola(v0, accel, float64(tick)*h)
+				if c.wantExact {
+					if math.Abs(x-want) > 1e-9 {
+						t.Fatalf("tick %d: x = %v, want %v (exact)", tick, x, want)
+					}
+					continue
+				}
+
+				// Euler's per-tick error against the analytic parabola is exactly
+				// -0.5*accel*h^2*tick for constant acceleration; assert the known bound rather
+				// than just checking that it drifted.
+				wantErr := -0.5 * accel * h * h * float64(tick)
+				gotErr := x - want
+				if math.Abs(gotErr-wantErr) > 1e-9 {
+					t.Fatalf("tick %d: euler error = %v, want %v", tick, gotErr, wantErr)
+				}
+			}
+		})
+	}
+}
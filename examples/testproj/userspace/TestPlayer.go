@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"galaxyzeta.io/engine/agent"
 	"galaxyzeta.io/engine/base"
 	"galaxyzeta.io/engine/collision"
 	"galaxyzeta.io/engine/core"
@@ -15,8 +16,6 @@ import (
 	"galaxyzeta.io/engine/ecs/system"
 	"galaxyzeta.io/engine/graphics"
 	"galaxyzeta.io/engine/infra/logger"
-	"galaxyzeta.io/engine/input"
-	"galaxyzeta.io/engine/input/keys"
 	"galaxyzeta.io/engine/linalg"
 	"galaxyzeta.io/engine/physics"
 	"galaxyzeta.io/engine/sdk"
@@ -32,15 +31,20 @@ type TestPlayer struct {
 	tf     *component.Transform2D
 	rb     *component.RigidBody2D
 	pc     *component.PolygonCollider
+	ctrl   *component.CharacterController2D
+	agent  agent.IPlayerAgent
 	csys   collision.ICollisionSystem
 	logger *logger.Logger
 
 	// -- user defined
-	canJump            bool          // whether the user can jump or not
 	lastJumpTime       time.Time     // last player jump time
 	jumpPreventionTime time.Duration // stop the user from operating a jump in this duration
 }
 
+func init() {
+	core.RegisterFactory("player", TestPlayer_OnCreate)
+}
+
 //TestPlayer_OnCreate is a public constructor.
 func TestPlayer_OnCreate() base.IGameObject2D {
 	fmt.Println("SDK Call onCreate")
@@ -53,15 +57,20 @@ func TestPlayer_OnCreate() base.IGameObject2D {
 	this.pc = component.NewPolygonCollider(spr.GetHitbox(&this.tf.Pos, physics.Pivot{
 		Option: physics.PivotOption_TopLeft,
 	}), this)
+	this.ctrl = component.NewCharacterController2D(8, 40, 16, this.rb, this.pc, this)
 	this.GameObject2D = base.NewGameObject2D("player").
 		RegisterRender(__TestPlayer_OnRender).
 		RegisterStep(__TestPlayer_OnStep).
 		RegisterDestroy(__TestPlayer_OnDestroy).
 		RegisterComponentIfAbsent(this.tf).
 		RegisterComponentIfAbsent(this.rb).
-		RegisterComponentIfAbsent(this.pc)
+		RegisterComponentIfAbsent(this.pc).
+		RegisterComponentIfAbsent(this.ctrl)
 	this.GameObject2D.Sprite = spr
 
+	this.agent = agent.NewKeyboardAgent()
+	sdk.SetPlayerAgent(this, this.agent)
+
 	// Enable gravity
 	this.rb.UseGravity = true
 	this.rb.SetGravity(270, 0.02)
@@ -69,6 +78,7 @@ func TestPlayer_OnCreate() base.IGameObject2D {
 	this.logger = logger.New("player")
 	this.csys = core.GetSystem(system.NameCollision2Dsystem).(collision.ICollisionSystem)
 
+	core.SubscribeSystem(this, system.NameCharacterControllerSystem)
 	core.SubscribeSystem(this, system.NamePhysics2DSystem)
 	core.SubscribeSystem(this, system.NameCollision2Dsystem)
 
@@ -83,28 +93,30 @@ func TestPlayer_OnCreate() base.IGameObject2D {
 // even damaging the whole game logic.
 func __TestPlayer_OnStep(obj base.IGameObject2D) {
 	this := obj.(*TestPlayer)
+	intent := this.agent.PollIntent(obj)
 	isKeyHeld := false
 	var dx float64 = 0
 	var dy float64 = 0
 
 	// movement
-	if input.IsKeyHeld(keys.KeyA) && !collision.HasColliderAtPolygonWithTag(this.csys, this.pc.Collider.Shift(-2, 0), "solid") {
+	if intent.MoveX < 0 && !collision.HasColliderAtPolygonWithTag(this.csys, this.pc.Collider.Shift(-2, 0), "solid") {
 		dx = -1
 		isKeyHeld = true
-	} else if input.IsKeyHeld(keys.KeyD) && !collision.HasColliderAtPolygonWithTag(this.csys, this.pc.Collider.Shift(2, 0), "solid") {
+	} else if intent.MoveX > 0 && !collision.HasColliderAtPolygonWithTag(this.csys, this.pc.Collider.Shift(2, 0), "solid") {
 		dx = 1
 		isKeyHeld = true
 	}
 
 	// jump
-	if input.IsKeyPressed(keys.KeyW) && this.canJump && time.Since(this.lastJumpTime) > this.jumpPreventionTime {
-		this.canJump = false
+	if intent.Jump && time.Since(this.lastJumpTime) > this.jumpPreventionTime && this.ctrl.TryJump(3) {
 		this.lastJumpTime = time.Now()
-		this.rb.AddForce(component.SpeedVector{
-			Acceleration: 0.05,
-			Direction:    90,
-			Speed:        3,
-		})
+	}
+
+	// crouch / stand
+	if intent.Crouch {
+		this.ctrl.TryCrouch()
+	} else {
+		this.ctrl.TryStandup(this.csys)
 	}
 
 	if isKeyHeld {
@@ -114,22 +126,6 @@ func __TestPlayer_OnStep(obj base.IGameObject2D) {
 	}
 
 	this.tf.Translate(dx, dy)
-
-	testPoly := this.pc.Collider.Shift(0, 1)
-	if val := collision.ColliderAtPolygonWithTag(this.csys, testPoly, "solid"); val != nil {
-		if time.Since(this.lastJumpTime) > this.jumpPreventionTime {
-			this.rb.UseGravity = false
-			this.rb.GravityVector.Speed = 0
-			this.canJump = true
-		}
-		// stick to the surface, do some trajetory correction
-		thisY := this.pc.Collider.GetBoundingBox().GetBottomLeftPoint().Y
-		colliderY := val.Collider.GetBoundingBox().GetTopLeftPoint().Y
-		this.tf.Pos.Y += (colliderY - thisY)
-	} else {
-		this.canJump = false
-		this.rb.UseGravity = true
-	}
 }
 
 func __TestPlayer_OnRender(obj base.IGameObject2D) {
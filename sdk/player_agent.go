@@ -0,0 +1,13 @@
+package sdk
+
+import (
+	"galaxyzeta.io/engine/agent"
+	"galaxyzeta.io/engine/base"
+)
+
+// SetPlayerAgent attaches a as obj's IPlayerAgent component. Game objects that poll
+// agent.IPlayerAgent in their OnStep callback should call this once, typically from their
+// OnCreate constructor, before relying on the attached agent.
+func SetPlayerAgent(obj base.IGameObject2D, a agent.IPlayerAgent) {
+	obj.GetGameObject2D().RegisterComponentIfAbsent(a)
+}
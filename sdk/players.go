@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"math"
+
+	"galaxyzeta.io/engine/base"
+	"galaxyzeta.io/engine/core"
+	"galaxyzeta.io/engine/ecs/component"
+	"galaxyzeta.io/engine/infra/logger"
+	"galaxyzeta.io/engine/linalg"
+)
+
+var playersLogger = logger.New("sdk")
+
+// playerFactoryName is the GameObject2D factory name player objects are registered under via
+// core.RegisterFactory, e.g. objs.TestPlayer_OnCreate registers itself as "player".
+const playerFactoryName = "player"
+
+// playerSpawnRadius is the radius of the circle AddPlayers places players on around a spawn
+// point; a single player also lands this far from the spawn point.
+const playerSpawnRadius = 24.0
+
+// ObjectHandle is an opaque reference to a GameObject2D created through the SDK.
+type ObjectHandle struct {
+	EngineID int64
+	obj      base.IGameObject2D
+}
+
+// Object returns the underlying IGameObject2D a handle refers to.
+func (h ObjectHandle) Object() base.IGameObject2D {
+	return h.obj
+}
+
+// AddPlayers spawns one player per entry in engineIDs on side, evenly distributed on a small
+// circle around that side's next spawn point, tags each with side, and pushes them through the
+// engine's register pipeline. It fatal-logs if no GameMode has been selected, or if side is out
+// of range for the active one.
+func AddPlayers(engineIDs []int64, side int) []ObjectHandle {
+	mode := core.GetGameMode()
+	if mode == nil {
+		playersLogger.Fatalf("AddPlayers: no GameMode selected, call Application.SetGameMode before spawning players")
+		return nil
+	}
+	if side < 0 || side >= mode.NumSides() {
+		playersLogger.Fatalf("AddPlayers: side %d out of range [0, %d) for the active GameMode", side, mode.NumSides())
+		return nil
+	}
+
+	spawn, err := mode.SpawnPoint(side)
+	if err != nil {
+		playersLogger.Fatalf("AddPlayers: %v", err)
+		return nil
+	}
+
+	handles := make([]ObjectHandle, 0, len(engineIDs))
+	n := float64(len(engineIDs))
+	for i, engineID := range engineIDs {
+		theta := 2 * math.Pi * float64(i) / n
+		pos := linalg.Vector2f64{
+			X: spawn.X + playerSpawnRadius*math.Cos(theta),
+			Y: spawn.Y + playerSpawnRadius*math.Sin(theta),
+		}
+
+		iobj := core.Instantiate(playerFactoryName)
+		go2d := iobj.GetGameObject2D()
+		tf := go2d.GetComponent(component.NameTransform2D).(*component.Transform2D)
+		tf.Pos = pos
+		core.SetSide(iobj, side)
+		core.PushRegister(iobj, true)
+
+		handles = append(handles, ObjectHandle{EngineID: engineID, obj: iobj})
+	}
+
+	return handles
+}